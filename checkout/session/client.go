@@ -0,0 +1,73 @@
+// Package session provides the /checkout/sessions APIs
+package session
+
+import (
+	"net/http"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// Client is used to invoke /checkout/sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a new Checkout Session.
+func New(params *stripe.SessionParams) (*stripe.Session, error) {
+	return getC().New(params)
+}
+
+// New creates a new Checkout Session.
+func (c Client) New(params *stripe.SessionParams) (*stripe.Session, error) {
+	session := &stripe.Session{}
+	err := c.B.Call(http.MethodPost, "/v1/checkout/sessions", c.Key, params, session)
+	return session, err
+}
+
+// Get returns the details of a Checkout Session.
+func Get(id string, params *stripe.Params) (*stripe.Session, error) {
+	return getC().Get(id, params)
+}
+
+// Get returns the details of a Checkout Session.
+func (c Client) Get(id string, params *stripe.Params) (*stripe.Session, error) {
+	path := stripe.FormatURLPath("/v1/checkout/sessions/%s", id)
+	session := &stripe.Session{}
+	err := c.B.Call(http.MethodGet, path, c.Key, params, session)
+	return session, err
+}
+
+// List returns a list of Checkout Sessions.
+func List(params *stripe.SessionListParams) *Iter {
+	return getC().List(params)
+}
+
+// List returns a list of Checkout Sessions.
+func (c Client) List(listParams *stripe.SessionListParams) *Iter {
+	return &Iter{stripe.GetIter(listParams, func(b *stripe.ListParams, p *stripe.Params) ([]interface{}, stripe.ListContainer, error) {
+		list := &stripe.SessionList{}
+		err := c.B.CallRaw(http.MethodGet, "/v1/checkout/sessions", c.Key, []byte(b.Encode()), p, list)
+
+		ret := make([]interface{}, len(list.Data))
+		for i, v := range list.Data {
+			ret[i] = v
+		}
+
+		return ret, list, err
+	})}
+}
+
+// Iter is an iterator for Checkout Sessions.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Session returns the Checkout Session which the iterator is currently pointing to.
+func (i *Iter) Session() *stripe.Session {
+	return i.Current().(*stripe.Session)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+}
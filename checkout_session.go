@@ -0,0 +1,82 @@
+package stripe
+
+import (
+	"encoding/json"
+)
+
+// SessionMode is the type of a Checkout Session, determining what it's used for.
+type SessionMode string
+
+// List of values that SessionMode can take.
+const (
+	SessionModePayment SessionMode = "payment"
+	SessionModeSetup   SessionMode = "setup"
+)
+
+// SessionLineItemParams is the set of parameters describing a single line
+// item to be displayed on the hosted Checkout page.
+type SessionLineItemParams struct {
+	Amount      *int64  `form:"amount"`
+	Currency    *string `form:"currency"`
+	Description *string `form:"description"`
+	Name        *string `form:"name"`
+	Quantity    *int64  `form:"quantity"`
+}
+
+// SessionParams is the set of parameters that can be used when creating a Checkout Session.
+type SessionParams struct {
+	Params             `form:"*"`
+	CancelURL          *string                  `form:"cancel_url"`
+	ClientReferenceID  *string                  `form:"client_reference_id"`
+	CustomerEmail      *string                  `form:"customer_email"`
+	LineItems          []*SessionLineItemParams `form:"line_items"`
+	Mode               *string                  `form:"mode"`
+	Order              *string                  `form:"order"`
+	PaymentMethodTypes []*string                `form:"payment_method_types"`
+	SuccessURL         *string                  `form:"success_url"`
+}
+
+// Session is the resource representing a Stripe Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions.
+type Session struct {
+	CancelURL          string      `json:"cancel_url"`
+	ClientReferenceID  string      `json:"client_reference_id"`
+	CustomerEmail      string      `json:"customer_email"`
+	ID                 string      `json:"id"`
+	Livemode           bool        `json:"livemode"`
+	Mode               SessionMode `json:"mode"`
+	Order              *Order      `json:"order"`
+	PaymentMethodTypes []string    `json:"payment_method_types"`
+	SuccessURL         string      `json:"success_url"`
+	URL                string      `json:"url"`
+}
+
+// SessionList is a list of Checkout Sessions as retrieved from a list endpoint.
+type SessionList struct {
+	ListMeta
+	Data []*Session `json:"data"`
+}
+
+// SessionListParams is the set of parameters that can be used when listing Checkout Sessions.
+type SessionListParams struct {
+	ListParams `form:"*"`
+}
+
+// UnmarshalJSON handles deserialization of a Session.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (s *Session) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
+	type session Session
+	var v session
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = Session(v)
+	return nil
+}
@@ -56,13 +56,15 @@ type OrderItemParent struct {
 
 // OrderParams is the set of parameters that can be used when creating an order.
 type OrderParams struct {
-	Params   `form:"*"`
-	Coupon   *string            `form:"coupon"`
-	Currency *string            `form:"currency"`
-	Customer *string            `form:"customer"`
-	Email    *string            `form:"email"`
-	Items    []*OrderItemParams `form:"items"`
-	Shipping *ShippingParams    `form:"shipping"`
+	Params               `form:"*"`
+	Coupon               *string              `form:"coupon"`
+	Currency             *string              `form:"currency"`
+	Customer             *string              `form:"customer"`
+	Email                *string              `form:"email"`
+	Items                []*OrderItemParams   `form:"items"`
+	Shipping             *ShippingParams      `form:"shipping"`
+	ShippingRateResolver ShippingRateResolver `form:"-"`
+	TaxProvider          TaxProvider          `form:"-"`
 }
 
 // ShippingParams is the set of parameters that can be used for the shipping hash
@@ -131,10 +133,12 @@ type Order struct {
 	AmountReturned         int64             `json:"amount_returned"`
 	Application            string            `json:"application"`
 	ApplicationFee         int64             `json:"application_fee"`
-	Charge                 *Charge           `json:"charge"`
+	Charge                 *Charge           `json:"-"`
+	ChargeID               string            `json:"-"`
 	Created                int64             `json:"created"`
 	Currency               Currency          `json:"currency"`
-	Customer               Customer          `json:"customer"`
+	Customer               *Customer         `json:"-"`
+	CustomerID             string            `json:"-"`
 	Email                  string            `json:"email"`
 	ID                     string            `json:"id"`
 	Items                  []*OrderItem      `json:"items"`
@@ -241,9 +245,12 @@ func (oi *OrderItem) UnmarshalJSON(data []byte) error {
 			oi.Parent.Type = OrderItemParentTypeShipping
 		}
 	case OrderItemTypeSKU:
-		if err = json.Unmarshal(*rawObject["parent"], &oi.Parent.SKU); err != nil {
-			oi.Parent.ID = oi.Parent.SKU.ID
-			oi.Parent.Type = OrderItemParentTypeSKU
+		oi.Parent.Type = OrderItemParentTypeSKU
+		if err = json.Unmarshal(*rawObject["parent"], &oi.Parent.ID); err != nil {
+			// parent wasn't a bare id, so it must be an expanded SKU object.
+			if err = json.Unmarshal(*rawObject["parent"], &oi.Parent.SKU); err == nil && oi.Parent.SKU != nil {
+				oi.Parent.ID = oi.Parent.SKU.ID
+			}
 		}
 	}
 
@@ -266,5 +273,35 @@ func (o *Order) UnmarshalJSON(data []byte) error {
 	}
 
 	*o = Order(v)
+
+	// Customer and Charge are excluded from the struct tags above because,
+	// like OrderItem.Parent, they may come back as a bare id or as the
+	// full expanded object depending on whether the caller asked for
+	// expansion.
+	var rawObject map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &rawObject); err != nil {
+		return err
+	}
+
+	if raw, ok := rawObject["customer"]; ok && raw != nil {
+		if err := json.Unmarshal(*raw, &o.CustomerID); err != nil {
+			o.Customer = &Customer{}
+			if err := json.Unmarshal(*raw, o.Customer); err != nil {
+				return err
+			}
+			o.CustomerID = o.Customer.ID
+		}
+	}
+
+	if raw, ok := rawObject["charge"]; ok && raw != nil {
+		if err := json.Unmarshal(*raw, &o.ChargeID); err != nil {
+			o.Charge = &Charge{}
+			if err := json.Unmarshal(*raw, o.Charge); err != nil {
+				return err
+			}
+			o.ChargeID = o.Charge.ID
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,270 @@
+// Package order provides the /orders APIs
+package order
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// Client is used to invoke /orders APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a new order.
+//
+// If params.TaxProvider is set, it's invoked with a preview of the order
+// being created so that the OrderItemTypeTax items it returns can be
+// appended to params.Items before the request is sent.
+//
+// TaxProvider previews the order from the Amount already set on each
+// OrderItemParams, so it requires every item to be explicitly priced by
+// the caller; it can't be used with SKU items that rely on Stripe to
+// price them server-side on creation (Amount left nil).
+func New(params *stripe.OrderParams) (*stripe.Order, error) {
+	return getC().New(params)
+}
+
+// New creates a new order.
+func (c Client) New(params *stripe.OrderParams) (*stripe.Order, error) {
+	sendParams := params
+
+	if params != nil && params.TaxProvider != nil {
+		if err := requirePricedItems(params.Items); err != nil {
+			return nil, err
+		}
+
+		taxItems, err := params.TaxProvider.CalculateTax(taxContext(params), previewOrder(params))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(taxItems) > 0 {
+			paramsCopy := *params
+			paramsCopy.Items = mergeTaxItems(params.Items, taxItems)
+			sendParams = &paramsCopy
+		}
+	}
+
+	order := &stripe.Order{}
+	if err := c.B.Call(http.MethodPost, "/v1/orders", c.Key, sendParams, order); err != nil {
+		return nil, err
+	}
+
+	if params != nil && params.ShippingRateResolver != nil {
+		var address *stripe.Address
+		if order.Shipping != nil {
+			address = order.Shipping.Address
+		}
+
+		methods, err := params.ShippingRateResolver.Resolve(taxContext(params), address, order.Items)
+		if err != nil {
+			return order, err
+		}
+		order.ShippingMethods = methods
+	}
+
+	return order, nil
+}
+
+// SelectAndUpdate picks a shipping method out of order.ShippingMethods
+// using selector (SelectCheapest and SelectFastest are built in) and
+// patches the order so SelectedShippingMethod reflects the choice. It's a
+// no-op, returning order unchanged, if selector returns nil.
+func SelectAndUpdate(id string, order *stripe.Order, selector func([]*stripe.ShippingMethod) *stripe.ShippingMethod) (*stripe.Order, error) {
+	return getC().SelectAndUpdate(id, order, selector)
+}
+
+// SelectAndUpdate picks a shipping method out of order.ShippingMethods
+// using selector and patches the order so SelectedShippingMethod reflects
+// the choice.
+func (c Client) SelectAndUpdate(id string, order *stripe.Order, selector func([]*stripe.ShippingMethod) *stripe.ShippingMethod) (*stripe.Order, error) {
+	method := selector(order.ShippingMethods)
+	if method == nil {
+		return order, nil
+	}
+
+	return c.Update(id, &stripe.OrderUpdateParams{SelectedShippingMethod: stripe.String(method.ID)})
+}
+
+// Get returns the details of an order.
+func Get(id string, params *stripe.Params) (*stripe.Order, error) {
+	return getC().Get(id, params)
+}
+
+// Get returns the details of an order.
+func (c Client) Get(id string, params *stripe.Params) (*stripe.Order, error) {
+	path := stripe.FormatURLPath("/v1/orders/%s", id)
+	order := &stripe.Order{}
+	err := c.B.Call(http.MethodGet, path, c.Key, params, order)
+	return order, err
+}
+
+// Update updates an order's properties.
+func Update(id string, params *stripe.OrderUpdateParams) (*stripe.Order, error) {
+	return getC().Update(id, params)
+}
+
+// Update updates an order's properties.
+func (c Client) Update(id string, params *stripe.OrderUpdateParams) (*stripe.Order, error) {
+	path := stripe.FormatURLPath("/v1/orders/%s", id)
+	order := &stripe.Order{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, order)
+	return order, err
+}
+
+// Pay pays an order.
+func Pay(id string, params *stripe.OrderPayParams) (*stripe.Order, error) {
+	return getC().Pay(id, params)
+}
+
+// Pay pays an order.
+func (c Client) Pay(id string, params *stripe.OrderPayParams) (*stripe.Order, error) {
+	path := stripe.FormatURLPath("/v1/orders/%s/pay", id)
+	order := &stripe.Order{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, order)
+	return order, err
+}
+
+// Return returns (part of) an order.
+func Return(id string, params *stripe.OrderReturnParams) (*stripe.OrderReturnList, error) {
+	return getC().Return(id, params)
+}
+
+// Return returns (part of) an order.
+func (c Client) Return(id string, params *stripe.OrderReturnParams) (*stripe.OrderReturnList, error) {
+	path := stripe.FormatURLPath("/v1/orders/%s/returns", id)
+	returns := &stripe.OrderReturnList{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, returns)
+	return returns, err
+}
+
+// List returns a list of orders.
+func List(params *stripe.OrderListParams) *Iter {
+	return getC().List(params)
+}
+
+// List returns a list of orders.
+func (c Client) List(listParams *stripe.OrderListParams) *Iter {
+	return &Iter{stripe.GetIter(listParams, func(b *stripe.ListParams, p *stripe.Params) ([]interface{}, stripe.ListContainer, error) {
+		list := &stripe.OrderList{}
+		err := c.B.CallRaw(http.MethodGet, "/v1/orders", c.Key, []byte(b.Encode()), p, list)
+
+		ret := make([]interface{}, len(list.Data))
+		for i, v := range list.Data {
+			ret[i] = v
+		}
+
+		return ret, list, err
+	})}
+}
+
+// Iter is an iterator for orders.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Order returns the order which the iterator is currently pointing to.
+func (i *Iter) Order() *stripe.Order {
+	return i.Current().(*stripe.Order)
+}
+
+// requirePricedItems guards against silently computing tax off a zero
+// subtotal: TaxProvider previews the order from OrderItemParams.Amount,
+// which is commonly left nil for SKU items that Stripe prices
+// server-side on creation.
+func requirePricedItems(items []*stripe.OrderItemParams) error {
+	for _, item := range items {
+		if item.Amount == nil {
+			return fmt.Errorf("stripe: TaxProvider requires Amount to be set on every order item; it can't compute tax for items priced by Stripe server-side")
+		}
+	}
+
+	return nil
+}
+
+// mergeTaxItems returns a new slice holding items followed by taxItems
+// converted to params, without modifying items' underlying array. New
+// must not mutate the caller's params.Items, since the same *OrderParams
+// may be reused or retried and would otherwise accumulate duplicate tax
+// items on every call.
+func mergeTaxItems(items []*stripe.OrderItemParams, taxItems []*stripe.OrderItem) []*stripe.OrderItemParams {
+	merged := append([]*stripe.OrderItemParams{}, items...)
+	for _, item := range taxItems {
+		merged = append(merged, &stripe.OrderItemParams{
+			Amount:      stripe.Int64(item.Amount),
+			Currency:    stripe.String(string(item.Currency)),
+			Description: stripe.String(item.Description),
+			Quantity:    stripe.Int64(item.Quantity),
+			Type:        stripe.String(string(item.Type)),
+		})
+	}
+
+	return merged
+}
+
+// previewOrder builds a transient *stripe.Order from params, good enough
+// for a TaxProvider or ShippingRateResolver to inspect the items and
+// shipping address of the order that's about to be created.
+func previewOrder(params *stripe.OrderParams) *stripe.Order {
+	order := &stripe.Order{}
+	if params.Currency != nil {
+		order.Currency = stripe.Currency(*params.Currency)
+	}
+
+	if params.Shipping != nil && params.Shipping.Address != nil {
+		order.Shipping = &stripe.Shipping{Address: previewAddress(params.Shipping.Address)}
+	}
+
+	for _, ip := range params.Items {
+		item := &stripe.OrderItem{}
+		if ip.Amount != nil {
+			item.Amount = *ip.Amount
+		}
+		if ip.Currency != nil {
+			item.Currency = stripe.Currency(*ip.Currency)
+		} else {
+			item.Currency = order.Currency
+		}
+		if ip.Description != nil {
+			item.Description = *ip.Description
+		}
+		if ip.Quantity != nil {
+			item.Quantity = *ip.Quantity
+		}
+		if ip.Type != nil {
+			item.Type = stripe.OrderItemType(*ip.Type)
+		}
+		order.Items = append(order.Items, item)
+	}
+
+	return order
+}
+
+func previewAddress(params *stripe.AddressParams) *stripe.Address {
+	return &stripe.Address{
+		City:       stripe.StringValue(params.City),
+		Country:    stripe.StringValue(params.Country),
+		Line1:      stripe.StringValue(params.Line1),
+		Line2:      stripe.StringValue(params.Line2),
+		PostalCode: stripe.StringValue(params.PostalCode),
+		State:      stripe.StringValue(params.State),
+	}
+}
+
+// taxContext returns the context that params was created with, falling
+// back to context.Background() when none was set.
+func taxContext(params *stripe.OrderParams) context.Context {
+	if params.Context != nil {
+		return params.Context
+	}
+	return context.Background()
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+}
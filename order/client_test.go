@@ -0,0 +1,43 @@
+package order
+
+import (
+	"testing"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+func TestRequirePricedItems(t *testing.T) {
+	priced := []*stripe.OrderItemParams{{Amount: stripe.Int64(1000)}}
+	if err := requirePricedItems(priced); err != nil {
+		t.Errorf("unexpected error for fully priced items: %v", err)
+	}
+
+	unpriced := []*stripe.OrderItemParams{{Amount: stripe.Int64(1000)}, {Parent: stripe.String("sku_123")}}
+	if err := requirePricedItems(unpriced); err == nil {
+		t.Error("expected an error when an item is missing Amount, got nil")
+	}
+}
+
+func TestMergeTaxItems(t *testing.T) {
+	items := []*stripe.OrderItemParams{{Amount: stripe.Int64(1000)}}
+	taxItems := []*stripe.OrderItem{{Amount: 80, Type: stripe.OrderItemTypeTax}}
+
+	merged := mergeTaxItems(items, taxItems)
+
+	if len(items) != 1 {
+		t.Fatalf("mergeTaxItems mutated the caller's items slice: len = %d, want 1", len(items))
+	}
+	if len(merged) != 2 {
+		t.Fatalf("got %d merged items, want 2", len(merged))
+	}
+	if *merged[1].Amount != 80 {
+		t.Errorf("got tax item Amount %d, want 80", *merged[1].Amount)
+	}
+
+	// Calling it again with the same original items must not accumulate
+	// tax items from the first call.
+	mergedAgain := mergeTaxItems(items, taxItems)
+	if len(mergedAgain) != 2 {
+		t.Fatalf("got %d items on second call, want 2 (tax items must not compound)", len(mergedAgain))
+	}
+}
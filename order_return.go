@@ -0,0 +1,103 @@
+package stripe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ReturnBuilder tracks how much of each OrderItem on an order has already
+// been returned, and builds OrderReturnParams that are safe to retry: the
+// same set of (sku, quantity) pairs always produces the same idempotency
+// key, so a retried request can't double-refund.
+type ReturnBuilder struct {
+	order     *Order
+	remaining map[string]int64
+	returns   map[string]int64
+}
+
+// NewReturnBuilder creates a ReturnBuilder for order, seeding remaining
+// returnable quantities from order.Items minus whatever is already present
+// in order.Returns.
+func NewReturnBuilder(order *Order) *ReturnBuilder {
+	rb := &ReturnBuilder{
+		order:     order,
+		remaining: make(map[string]int64),
+		returns:   make(map[string]int64),
+	}
+
+	for _, item := range order.Items {
+		if item.Type != OrderItemTypeSKU || item.Parent == nil {
+			continue
+		}
+		rb.remaining[item.Parent.ID] += item.Quantity
+	}
+
+	if order.Returns != nil {
+		for _, ret := range order.Returns.Data {
+			for _, item := range ret.Items {
+				if item.Type != OrderItemTypeSKU || item.Parent == nil {
+					continue
+				}
+				rb.remaining[item.Parent.ID] -= item.Quantity
+			}
+		}
+	}
+
+	return rb
+}
+
+// Remaining returns the quantity of sku that can still be returned.
+func (rb *ReturnBuilder) Remaining(sku string) int64 {
+	return rb.remaining[sku]
+}
+
+// AddReturn marks qty units of sku to be returned, returning an error if
+// doing so would exceed the remaining returnable quantity for that sku.
+func (rb *ReturnBuilder) AddReturn(sku string, qty int64) error {
+	if qty <= 0 {
+		return fmt.Errorf("stripe: return quantity must be positive, got %d", qty)
+	}
+
+	if qty > rb.remaining[sku] {
+		return fmt.Errorf("stripe: cannot return %d of sku %q, only %d remaining", qty, sku, rb.remaining[sku])
+	}
+
+	rb.remaining[sku] -= qty
+	rb.returns[sku] += qty
+	return nil
+}
+
+// Params builds the OrderReturnParams for everything added via AddReturn
+// so far, with an idempotency key deterministically derived from the
+// order ID and the sorted set of (sku, quantity) pairs being returned, so
+// retrying the exact same return is always recognized by Stripe as the
+// same request.
+func (rb *ReturnBuilder) Params() *OrderReturnParams {
+	skus := make([]string, 0, len(rb.returns))
+	for sku := range rb.returns {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	params := &OrderReturnParams{}
+	key := strings.Builder{}
+	key.WriteString(rb.order.ID)
+
+	for _, sku := range skus {
+		qty := rb.returns[sku]
+		params.Items = append(params.Items, &OrderItemParams{
+			Parent:   String(sku),
+			Quantity: Int64(qty),
+			Type:     String(string(OrderItemTypeSKU)),
+		})
+		fmt.Fprintf(&key, "|%s:%d", sku, qty)
+	}
+
+	sum := sha256.Sum256([]byte(key.String()))
+	params.IdempotencyKey = String(hex.EncodeToString(sum[:]))
+
+	return params
+}
@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// unexpandedSKUOrderJSON is representative of what the API actually
+// returns for an order's items when SKUs aren't expanded: "parent" is a
+// bare sku id, not an object.
+const unexpandedSKUOrderJSON = `{
+	"id": "or_123",
+	"items": [
+		{"type": "sku", "parent": "sku_123", "amount": 1000, "currency": "usd", "quantity": 3},
+		{"type": "sku", "parent": "sku_456", "amount": 500, "currency": "usd", "quantity": 2}
+	]
+}`
+
+func mustUnmarshalOrder(t *testing.T, data string) *Order {
+	t.Helper()
+
+	var o Order
+	if err := json.Unmarshal([]byte(data), &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &o
+}
+
+func TestNewReturnBuilder_UnexpandedSKUs(t *testing.T) {
+	o := mustUnmarshalOrder(t, unexpandedSKUOrderJSON)
+
+	rb := NewReturnBuilder(o)
+
+	if got := rb.Remaining("sku_123"); got != 3 {
+		t.Errorf("Remaining(sku_123) = %d, want 3", got)
+	}
+	if got := rb.Remaining("sku_456"); got != 2 {
+		t.Errorf("Remaining(sku_456) = %d, want 2", got)
+	}
+}
+
+func TestReturnBuilder_AddReturn(t *testing.T) {
+	o := mustUnmarshalOrder(t, unexpandedSKUOrderJSON)
+	rb := NewReturnBuilder(o)
+
+	if err := rb.AddReturn("sku_123", 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rb.Remaining("sku_123"); got != 1 {
+		t.Errorf("Remaining(sku_123) after return = %d, want 1", got)
+	}
+
+	if err := rb.AddReturn("sku_123", 2); err == nil {
+		t.Error("expected error returning more than remaining quantity, got nil")
+	}
+
+	if err := rb.AddReturn("sku_456", 0); err == nil {
+		t.Error("expected error for non-positive quantity, got nil")
+	}
+}
+
+func TestReturnBuilder_Params(t *testing.T) {
+	o := mustUnmarshalOrder(t, unexpandedSKUOrderJSON)
+	rb := NewReturnBuilder(o)
+
+	if err := rb.AddReturn("sku_123", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := rb.AddReturn("sku_456", 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	params := rb.Params()
+	if len(params.Items) != 2 {
+		t.Fatalf("got %d items, want 2", len(params.Items))
+	}
+	if params.IdempotencyKey == nil || *params.IdempotencyKey == "" {
+		t.Fatal("expected a non-empty idempotency key")
+	}
+
+	// Building the same return again from a fresh builder must produce the
+	// same idempotency key, so retries are recognized as duplicates.
+	rb2 := NewReturnBuilder(o)
+	if err := rb2.AddReturn("sku_456", 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := rb2.AddReturn("sku_123", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	params2 := rb2.Params()
+	if *params2.IdempotencyKey != *params.IdempotencyKey {
+		t.Errorf("idempotency keys differ for the same return: %q != %q", *params2.IdempotencyKey, *params.IdempotencyKey)
+	}
+}
@@ -0,0 +1,73 @@
+package stripe
+
+import "context"
+
+// ShippingRateResolver computes the shipping methods available for an
+// order given its destination address and items. It's invoked by the
+// order client's New method right after the order is created, so that
+// order.ShippingMethods reflects live carrier rates (UPS/FedEx/a custom
+// rating API) rather than whatever static methods are configured on the
+// Stripe dashboard.
+type ShippingRateResolver interface {
+	Resolve(ctx context.Context, address *Address, items []*OrderItem) ([]*ShippingMethod, error)
+}
+
+// SelectCheapest returns the ShippingMethod with the lowest Amount, or nil
+// if methods is empty.
+func SelectCheapest(methods []*ShippingMethod) *ShippingMethod {
+	return selectShippingMethod(methods, func(best, candidate *ShippingMethod) bool {
+		return candidate.Amount < best.Amount
+	})
+}
+
+// SelectFastest returns the ShippingMethod whose DeliveryEstimate resolves
+// to the earliest date, or nil if methods is empty. Methods without a
+// DeliveryEstimate are treated as arriving last.
+func SelectFastest(methods []*ShippingMethod) *ShippingMethod {
+	return selectShippingMethod(methods, func(best, candidate *ShippingMethod) bool {
+		return deliveryEstimateRank(candidate.DeliveryEstimate) < deliveryEstimateRank(best.DeliveryEstimate)
+	})
+}
+
+func selectShippingMethod(methods []*ShippingMethod, less func(best, candidate *ShippingMethod) bool) *ShippingMethod {
+	if len(methods) == 0 {
+		return nil
+	}
+
+	best := methods[0]
+	for _, m := range methods[1:] {
+		if less(best, m) {
+			best = m
+		}
+	}
+
+	return best
+}
+
+// deliveryEstimateRank returns a value that sorts earlier for estimates
+// that arrive sooner, so instances can be compared lexicographically. A
+// nil estimate sorts last.
+func deliveryEstimateRank(e *DeliveryEstimate) string {
+	if e == nil {
+		return "9999-99-99"
+	}
+
+	if e.Type == OrderDeliveryEstimateTypeRange {
+		return e.Earliest
+	}
+
+	return e.Date
+}
+
+// NewExactDeliveryEstimate builds a DeliveryEstimate for a shipping method
+// that's guaranteed to arrive on a specific date, formatted as YYYY-MM-DD.
+func NewExactDeliveryEstimate(date string) *DeliveryEstimate {
+	return &DeliveryEstimate{Date: date, Type: OrderDeliveryEstimateTypeExact}
+}
+
+// NewRangeDeliveryEstimate builds a DeliveryEstimate for a shipping method
+// that's expected to arrive sometime between earliest and latest, both
+// formatted as YYYY-MM-DD.
+func NewRangeDeliveryEstimate(earliest, latest string) *DeliveryEstimate {
+	return &DeliveryEstimate{Earliest: earliest, Latest: latest, Type: OrderDeliveryEstimateTypeRange}
+}
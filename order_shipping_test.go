@@ -0,0 +1,61 @@
+package stripe
+
+import "testing"
+
+func TestSelectCheapest(t *testing.T) {
+	methods := []*ShippingMethod{
+		{ID: "sm_expensive", Amount: 1500},
+		{ID: "sm_cheap", Amount: 500},
+		{ID: "sm_mid", Amount: 900},
+	}
+
+	got := SelectCheapest(methods)
+	if got == nil || got.ID != "sm_cheap" {
+		t.Errorf("got %v, want sm_cheap", got)
+	}
+}
+
+func TestSelectCheapest_Empty(t *testing.T) {
+	if got := SelectCheapest(nil); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestSelectFastest(t *testing.T) {
+	methods := []*ShippingMethod{
+		{ID: "sm_slow", DeliveryEstimate: NewExactDeliveryEstimate("2026-08-10")},
+		{ID: "sm_fast", DeliveryEstimate: NewRangeDeliveryEstimate("2026-08-01", "2026-08-03")},
+		{ID: "sm_none"},
+	}
+
+	got := SelectFastest(methods)
+	if got == nil || got.ID != "sm_fast" {
+		t.Errorf("got %v, want sm_fast", got)
+	}
+}
+
+func TestSelectFastest_Empty(t *testing.T) {
+	if got := SelectFastest(nil); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestNewExactDeliveryEstimate(t *testing.T) {
+	e := NewExactDeliveryEstimate("2026-08-01")
+	if e.Type != OrderDeliveryEstimateTypeExact {
+		t.Errorf("got Type %q, want %q", e.Type, OrderDeliveryEstimateTypeExact)
+	}
+	if e.Date != "2026-08-01" {
+		t.Errorf("got Date %q, want %q", e.Date, "2026-08-01")
+	}
+}
+
+func TestNewRangeDeliveryEstimate(t *testing.T) {
+	e := NewRangeDeliveryEstimate("2026-08-01", "2026-08-05")
+	if e.Type != OrderDeliveryEstimateTypeRange {
+		t.Errorf("got Type %q, want %q", e.Type, OrderDeliveryEstimateTypeRange)
+	}
+	if e.Earliest != "2026-08-01" || e.Latest != "2026-08-05" {
+		t.Errorf("got Earliest/Latest %q/%q, want %q/%q", e.Earliest, e.Latest, "2026-08-01", "2026-08-05")
+	}
+}
@@ -0,0 +1,80 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// orderTransitions enumerates the legal OrderStatus transitions. An order
+// moves created -> paid -> fulfilled; it can be canceled while created or
+// paid, and returned once paid or fulfilled. Returned and canceled are
+// final, and anything not listed here (e.g. returned -> paid, or
+// fulfilled -> canceled) is rejected.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusCreated:   {OrderStatusPaid, OrderStatusCanceled},
+	OrderStatusPaid:      {OrderStatusFulfilled, OrderStatusReturned, OrderStatusCanceled},
+	OrderStatusFulfilled: {OrderStatusReturned},
+	OrderStatusReturned:  {},
+	OrderStatusCanceled:  {},
+}
+
+// OrderStateMachine enforces the legal lifecycle transitions of an order
+// and turns order.updated webhook events into typed status changes.
+type OrderStateMachine struct{}
+
+// CanTransition reports whether an order is allowed to move from one
+// status to another.
+func (OrderStateMachine) CanTransition(from, to OrderStatus) bool {
+	for _, allowed := range orderTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition builds the OrderUpdateParams that move o to status to,
+// returning an error instead if that transition isn't legal from o's
+// current status.
+func (sm OrderStateMachine) Transition(o *Order, to OrderStatus, meta map[string]string) (*OrderUpdateParams, error) {
+	from := OrderStatus(o.Status)
+	if !sm.CanTransition(from, to) {
+		return nil, fmt.Errorf("stripe: illegal order status transition from %q to %q", from, to)
+	}
+
+	params := &OrderUpdateParams{Status: String(string(to))}
+	for k, v := range meta {
+		params.AddMetadata(k, v)
+	}
+
+	return params, nil
+}
+
+// Dispatch consumes an order.updated webhook event and reports the order
+// it concerns along with its status before and after the update. It
+// returns an error if event isn't an order.updated event or its payload
+// can't be parsed into an Order.
+func (sm OrderStateMachine) Dispatch(event Event) (*Order, OrderStatus, OrderStatus, error) {
+	if event.Type != "order.updated" {
+		return nil, "", "", fmt.Errorf("stripe: Dispatch called with non-order event %q", event.Type)
+	}
+
+	raw, err := json.Marshal(event.Data.Object)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	order := &Order{}
+	if err := json.Unmarshal(raw, order); err != nil {
+		return nil, "", "", err
+	}
+
+	after := OrderStatus(order.Status)
+	before := after
+	if prev, ok := event.Data.PreviousAttributes["status"].(string); ok {
+		before = OrderStatus(prev)
+	}
+
+	return order, before, after, nil
+}
@@ -0,0 +1,92 @@
+package stripe
+
+import "testing"
+
+func TestOrderStateMachine_CanTransition(t *testing.T) {
+	sm := OrderStateMachine{}
+
+	cases := []struct {
+		from, to OrderStatus
+		want     bool
+	}{
+		{OrderStatusCreated, OrderStatusPaid, true},
+		{OrderStatusCreated, OrderStatusCanceled, true},
+		{OrderStatusCreated, OrderStatusFulfilled, false},
+		{OrderStatusPaid, OrderStatusFulfilled, true},
+		{OrderStatusPaid, OrderStatusReturned, true},
+		{OrderStatusFulfilled, OrderStatusReturned, true},
+		{OrderStatusReturned, OrderStatusPaid, false},
+		{OrderStatusCanceled, OrderStatusPaid, false},
+	}
+
+	for _, c := range cases {
+		if got := sm.CanTransition(c.from, c.to); got != c.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestOrderStateMachine_Transition(t *testing.T) {
+	sm := OrderStateMachine{}
+	o := &Order{ID: "or_123", Status: string(OrderStatusPaid)}
+
+	params, err := sm.Transition(o, OrderStatusFulfilled, map[string]string{"tracking": "1Z999"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Status == nil || *params.Status != string(OrderStatusFulfilled) {
+		t.Errorf("got Status %v, want %q", params.Status, OrderStatusFulfilled)
+	}
+	if params.Metadata["tracking"] != "1Z999" {
+		t.Errorf("got metadata %v, want tracking=1Z999", params.Metadata)
+	}
+
+	if _, err := sm.Transition(o, OrderStatusReturned, nil); err != nil {
+		t.Fatalf("unexpected error transitioning paid -> returned: %v", err)
+	}
+
+	o.Status = string(OrderStatusReturned)
+	if _, err := sm.Transition(o, OrderStatusPaid, nil); err == nil {
+		t.Error("expected error transitioning returned -> paid, got nil")
+	}
+}
+
+func TestOrderStateMachine_Dispatch(t *testing.T) {
+	sm := OrderStateMachine{}
+
+	event := Event{
+		Type: "order.updated",
+		Data: &EventData{
+			Object: map[string]interface{}{
+				"id":     "or_123",
+				"status": "fulfilled",
+			},
+			PreviousAttributes: map[string]interface{}{
+				"status": "paid",
+			},
+		},
+	}
+
+	order, before, after, err := sm.Dispatch(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.ID != "or_123" {
+		t.Errorf("got order id %q, want or_123", order.ID)
+	}
+	if before != OrderStatusPaid {
+		t.Errorf("got before status %q, want paid", before)
+	}
+	if after != OrderStatusFulfilled {
+		t.Errorf("got after status %q, want fulfilled", after)
+	}
+}
+
+func TestOrderStateMachine_Dispatch_WrongEventType(t *testing.T) {
+	sm := OrderStateMachine{}
+
+	event := Event{Type: "charge.succeeded", Data: &EventData{}}
+	if _, _, _, err := sm.Dispatch(event); err == nil {
+		t.Error("expected error dispatching a non-order event, got nil")
+	}
+}
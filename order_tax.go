@@ -0,0 +1,139 @@
+package stripe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TaxProvider computes the tax line items that should be applied to an
+// order before it's created. When OrderParams.TaxProvider is set, the
+// order client's New method invokes CalculateTax and appends the
+// resulting OrderItemTypeTax items to the request before it's sent to
+// POST /v1/orders.
+//
+// The Order passed to CalculateTax is a preview built from OrderParams,
+// so it only has accurate Amounts when the caller set Amount explicitly
+// on every OrderItemParams; it isn't resolved from SKU price lookups. The
+// order client rejects OrderParams with a TaxProvider set but any item
+// missing Amount, rather than silently taxing a zero subtotal.
+type TaxProvider interface {
+	CalculateTax(ctx context.Context, order *Order) ([]*OrderItem, error)
+}
+
+// StaticRateProvider is a TaxProvider that applies a flat percentage rate
+// to an order's subtotal based on its currency, ignoring shipping address
+// and SKU tax codes.
+type StaticRateProvider struct {
+	// Rates maps a currency to the percentage (e.g. 0.0825 for 8.25%) that
+	// should be applied to the order's subtotal.
+	Rates map[Currency]float64
+
+	// Description is used as the description of the generated tax line
+	// item. Defaults to "Tax" when empty.
+	Description string
+}
+
+// CalculateTax returns a single OrderItemTypeTax item computed from the
+// order's subtotal and the rate configured for its currency. It returns no
+// items, and no error, when no rate is configured for the currency.
+func (p *StaticRateProvider) CalculateTax(ctx context.Context, order *Order) ([]*OrderItem, error) {
+	rate, ok := p.Rates[order.Currency]
+	if !ok {
+		return nil, nil
+	}
+
+	var subtotal int64
+	for _, item := range order.Items {
+		subtotal += item.Amount * item.Quantity
+	}
+
+	if subtotal == 0 && len(order.Items) > 0 {
+		return nil, fmt.Errorf("stripe: StaticRateProvider computed a zero subtotal; set Amount on every order item before using a TaxProvider")
+	}
+
+	description := p.Description
+	if description == "" {
+		description = "Tax"
+	}
+
+	return []*OrderItem{
+		{
+			Amount:      int64(float64(subtotal) * rate),
+			Currency:    order.Currency,
+			Description: description,
+			Quantity:    1,
+			Type:        OrderItemTypeTax,
+		},
+	}, nil
+}
+
+// HTTPProvider is a TaxProvider that delegates the calculation to an
+// external endpoint. The endpoint is posted the order as JSON and is
+// expected to respond with a JSON array of
+// {"amount", "description", "jurisdiction"} objects.
+type HTTPProvider struct {
+	URL    string
+	Client *http.Client
+}
+
+type httpProviderTaxLine struct {
+	Amount       int64  `json:"amount"`
+	Description  string `json:"description"`
+	Jurisdiction string `json:"jurisdiction"`
+}
+
+// CalculateTax posts the order to the configured endpoint and translates
+// the response into OrderItemTypeTax items.
+func (p *HTTPProvider) CalculateTax(ctx context.Context, order *Order) ([]*OrderItem, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(order)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("stripe: tax provider returned status %d", resp.StatusCode)
+	}
+
+	var lines []httpProviderTaxLine
+	if err := json.NewDecoder(resp.Body).Decode(&lines); err != nil {
+		return nil, err
+	}
+
+	items := make([]*OrderItem, len(lines))
+	for i, line := range lines {
+		description := line.Description
+		if line.Jurisdiction != "" {
+			description = fmt.Sprintf("%s (%s)", description, line.Jurisdiction)
+		}
+
+		items[i] = &OrderItem{
+			Amount:      line.Amount,
+			Currency:    order.Currency,
+			Description: description,
+			Quantity:    1,
+			Type:        OrderItemTypeTax,
+		}
+	}
+
+	return items, nil
+}
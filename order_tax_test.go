@@ -0,0 +1,105 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStaticRateProvider_CalculateTax(t *testing.T) {
+	provider := &StaticRateProvider{Rates: map[Currency]float64{USD: 0.1}}
+
+	order := &Order{
+		Currency: USD,
+		Items: []*OrderItem{
+			{Amount: 1000, Quantity: 2, Type: OrderItemTypeSKU},
+		},
+	}
+
+	items, err := provider.CalculateTax(context.Background(), order)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, want 1", len(items))
+	}
+	if items[0].Amount != 200 {
+		t.Errorf("got Amount %d, want 200", items[0].Amount)
+	}
+	if items[0].Type != OrderItemTypeTax {
+		t.Errorf("got Type %q, want %q", items[0].Type, OrderItemTypeTax)
+	}
+}
+
+func TestStaticRateProvider_CalculateTax_NoRateConfigured(t *testing.T) {
+	provider := &StaticRateProvider{Rates: map[Currency]float64{EUR: 0.2}}
+	order := &Order{Currency: USD, Items: []*OrderItem{{Amount: 1000, Quantity: 1}}}
+
+	items, err := provider.CalculateTax(context.Background(), order)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items != nil {
+		t.Errorf("got items %v, want nil", items)
+	}
+}
+
+func TestStaticRateProvider_CalculateTax_ZeroSubtotal(t *testing.T) {
+	provider := &StaticRateProvider{Rates: map[Currency]float64{USD: 0.1}}
+
+	// Amount left at its zero value, as happens when a caller relies on
+	// Stripe pricing a SKU item server-side.
+	order := &Order{Currency: USD, Items: []*OrderItem{{Quantity: 1, Type: OrderItemTypeSKU}}}
+
+	if _, err := provider.CalculateTax(context.Background(), order); err == nil {
+		t.Error("expected an error for a zero subtotal, got nil")
+	}
+}
+
+func TestHTTPProvider_CalculateTax(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var order Order
+		if err := json.NewDecoder(r.Body).Decode(&order); err != nil {
+			t.Fatalf("unexpected error decoding request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"amount": 150, "description": "State tax", "jurisdiction": "CA"},
+		})
+	}))
+	defer server.Close()
+
+	provider := &HTTPProvider{URL: server.URL}
+	order := &Order{Currency: USD, Items: []*OrderItem{{Amount: 1000, Quantity: 1}}}
+
+	items, err := provider.CalculateTax(context.Background(), order)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, want 1", len(items))
+	}
+	if items[0].Amount != 150 {
+		t.Errorf("got Amount %d, want 150", items[0].Amount)
+	}
+	if items[0].Description != "State tax (CA)" {
+		t.Errorf("got Description %q, want %q", items[0].Description, "State tax (CA)")
+	}
+}
+
+func TestHTTPProvider_CalculateTax_NonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	provider := &HTTPProvider{URL: server.URL}
+	order := &Order{Currency: USD}
+
+	if _, err := provider.CalculateTax(context.Background(), order); err == nil {
+		t.Error("expected an error for a non-200 response, got nil")
+	}
+}
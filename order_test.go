@@ -0,0 +1,76 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrder_UnmarshalJSON_CustomerID(t *testing.T) {
+	data := []byte(`{"id": "or_123", "customer": "cus_123"}`)
+
+	var o Order
+	if err := json.Unmarshal(data, &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if o.CustomerID != "cus_123" {
+		t.Errorf("got CustomerID %q, want %q", o.CustomerID, "cus_123")
+	}
+	if o.Customer != nil {
+		t.Errorf("got Customer %+v, want nil", o.Customer)
+	}
+}
+
+func TestOrder_UnmarshalJSON_CustomerExpanded(t *testing.T) {
+	data := []byte(`{"id": "or_123", "customer": {"id": "cus_123", "object": "customer"}}`)
+
+	var o Order
+	if err := json.Unmarshal(data, &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if o.Customer == nil {
+		t.Fatal("got nil Customer, want expanded object")
+	}
+	if o.Customer.ID != "cus_123" {
+		t.Errorf("got Customer.ID %q, want %q", o.Customer.ID, "cus_123")
+	}
+	if o.CustomerID != "cus_123" {
+		t.Errorf("got CustomerID %q, want %q", o.CustomerID, "cus_123")
+	}
+}
+
+func TestOrder_UnmarshalJSON_ChargeID(t *testing.T) {
+	data := []byte(`{"id": "or_123", "charge": "ch_123"}`)
+
+	var o Order
+	if err := json.Unmarshal(data, &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if o.ChargeID != "ch_123" {
+		t.Errorf("got ChargeID %q, want %q", o.ChargeID, "ch_123")
+	}
+	if o.Charge != nil {
+		t.Errorf("got Charge %+v, want nil", o.Charge)
+	}
+}
+
+func TestOrder_UnmarshalJSON_ChargeExpanded(t *testing.T) {
+	data := []byte(`{"id": "or_123", "charge": {"id": "ch_123", "object": "charge"}}`)
+
+	var o Order
+	if err := json.Unmarshal(data, &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if o.Charge == nil {
+		t.Fatal("got nil Charge, want expanded object")
+	}
+	if o.Charge.ID != "ch_123" {
+		t.Errorf("got Charge.ID %q, want %q", o.Charge.ID, "ch_123")
+	}
+	if o.ChargeID != "ch_123" {
+		t.Errorf("got ChargeID %q, want %q", o.ChargeID, "ch_123")
+	}
+}